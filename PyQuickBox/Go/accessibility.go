@@ -0,0 +1,34 @@
+package main
+
+import "os"
+
+// --- 접근성 설정 ---
+// 깜빡이는 애니메이션(이름 실행 시 배경 플래시 등)에 민감한 사용자를 위해
+// "모션 감소"를 지원한다. 환경변수 PYQUICKBOX_REDUCED_MOTION으로 이미 모션 감소가
+// 켜져 있으면 그것을 우선하고, 그렇지 않으면 설정 다이얼로그의 토글 값을 따른다.
+
+const KeyReducedMotion = "ReducedMotion"
+
+// osPrefersReducedMotion은 환경변수 PYQUICKBOX_REDUCED_MOTION이 "1"이면 true를 반환한다.
+// Fyne은 플랫폼별 "prefers-reduced-motion" API를 노출하지 않으며, 이 함수도
+// OS나 데스크톱 환경(GNOME/KDE 등)의 모션 설정 자체는 읽지 않는다.
+func osPrefersReducedMotion() bool {
+	return os.Getenv("PYQUICKBOX_REDUCED_MOTION") == "1"
+}
+
+// AccessibilitySettings는 접근성 관련 전역 설정을 모아둔다.
+type AccessibilitySettings struct {
+	ReducedMotion bool
+}
+
+func (l *LauncherApp) loadAccessibilityPreferences() {
+	if osPrefersReducedMotion() {
+		l.Accessibility.ReducedMotion = true
+		return
+	}
+	l.Accessibility.ReducedMotion = l.App.Preferences().Bool(KeyReducedMotion)
+}
+
+func (l *LauncherApp) saveAccessibilityPreferences() {
+	l.App.Preferences().SetBool(KeyReducedMotion, l.Accessibility.ReducedMotion)
+}
@@ -0,0 +1,146 @@
+package main
+
+// --- 애니메이션 엔진 (easing + 트랙 합성) ---
+// animateLaunch가 0.5 경계로 if/else 분기하며 Translucency/FillColor를 직접
+// 건드리던 것을, 이름 있는 easing과 조합 가능한 트랙(Opacity/ColorLerp/Scale)
+// 위에서 동작하는 Animator로 추출한다. ReducedMotion이 켜져 있으면 Start는
+// 깜빡임 없이 즉시 최종 상태로 건너뛴다.
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// Easing maps a linear progress value in [0, 1] to an eased progress value.
+type Easing func(t float32) float32
+
+// Linear는 가속/감속 없이 그대로 진행한다.
+func Linear(t float32) float32 { return t }
+
+// EaseInOut은 시작과 끝에서 느려지는 3차 easing이다.
+func EaseInOut(t float32) float32 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	f := -2*t + 2
+	return 1 - float32(math.Pow(float64(f), 3))/2
+}
+
+// EaseOutBack은 끝에서 살짝 오버슈트했다가 되돌아오는 easing이다.
+func EaseOutBack(t float32) float32 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+	f := t - 1
+	return 1 + c3*float32(math.Pow(float64(f), 3)) + c1*float32(math.Pow(float64(f), 2))
+}
+
+// Spring은 감쇠 진동하며 1에 수렴하는 스프링형 easing이다.
+func Spring(t float32) float32 {
+	return float32(1 - math.Cos(float64(t)*math.Pi*1.5)*math.Exp(-float64(t)*6))
+}
+
+// Track은 0..1 진행도를 받아 대상 속성에 직접 적용하는 애니메이션 한 갈래다.
+type Track interface {
+	Apply(t float32)
+}
+
+// TrackFunc은 평범한 함수를 Track으로 쓸 수 있게 해준다.
+type TrackFunc func(t float32)
+
+// Apply implements Track.
+func (f TrackFunc) Apply(t float32) { f(t) }
+
+// Opacity는 set으로 전달된 콜백을 통해 from..to 사이의 불투명도를 적용하는 트랙을 만든다.
+func Opacity(set func(float64), from, to float64) Track {
+	return TrackFunc(func(t float32) {
+		set(from + float64(t)*(to-from))
+	})
+}
+
+// ColorLerp는 from..to 색상 사이를 선형 보간하는 트랙을 만든다.
+func ColorLerp(set func(color.Color), from, to color.Color) Track {
+	return TrackFunc(func(t float32) {
+		set(lerpColor(from, to, t))
+	})
+}
+
+// Scale은 obj를 base 크기 기준 from..to 배율로 리사이즈하는 트랙을 만든다.
+// Fyne은 캔버스 오브젝트에 대한 진짜 스케일 변환을 제공하지 않으므로,
+// 크기 자체를 보간하는 근사적인 방식을 쓴다.
+func Scale(obj fyne.CanvasObject, base fyne.Size, from, to float32) Track {
+	return TrackFunc(func(t float32) {
+		factor := from + t*(to-from)
+		obj.Resize(fyne.NewSize(base.Width*factor, base.Height*factor))
+	})
+}
+
+// Chain은 여러 트랙을 하나로 이어 붙여, 전체 진행도를 트랙 수만큼 균등하게 나눈
+// 구간별로 각 트랙을 순서대로 재생하는 트랙을 만든다 (예: 페이드아웃 -> 페이드인).
+func Chain(tracks ...Track) Track {
+	n := len(tracks)
+	return TrackFunc(func(t float32) {
+		if n == 0 {
+			return
+		}
+		segment := float32(1) / float32(n)
+		idx := int(t / segment)
+		if idx >= n {
+			idx = n - 1
+		}
+		local := (t - float32(idx)*segment) / segment
+		tracks[idx].Apply(local)
+	})
+}
+
+func lerpColor(from, to color.Color, t float32) color.Color {
+	fr, fg, fb, fa := from.RGBA()
+	tr, tg, tb, ta := to.RGBA()
+	lerp := func(a, b uint32) uint8 {
+		return uint8((float32(a) + t*(float32(b)-float32(a))) / 257)
+	}
+	return color.NRGBA{R: lerp(fr, tr), G: lerp(fg, tg), B: lerp(fb, tb), A: lerp(fa, ta)}
+}
+
+// Animator는 하나의 Duration/Easing 아래 여러 Track을 함께 재생한다.
+// ReducedMotion이 true면 Start는 애니메이션 없이 모든 트랙을 즉시 종료 상태(t=1)로 적용한다.
+type Animator struct {
+	Duration      time.Duration
+	Easing        Easing
+	Tracks        []Track
+	ReducedMotion bool
+}
+
+// NewAnimator는 주어진 지속 시간과 easing으로 빈 Animator를 만든다.
+func NewAnimator(duration time.Duration, easing Easing) *Animator {
+	if easing == nil {
+		easing = Linear
+	}
+	return &Animator{Duration: duration, Easing: easing}
+}
+
+// AddTrack은 트랙을 추가하고 체이닝을 위해 자기 자신을 반환한다.
+func (a *Animator) AddTrack(t Track) *Animator {
+	a.Tracks = append(a.Tracks, t)
+	return a
+}
+
+// Start는 애니메이션을 재생한다. ReducedMotion이 켜져 있으면 깜빡임 없이
+// 즉시 최종 상태로 건너뛴다 (시각 자극에 민감한 사용자를 위한 접근성 고려).
+func (a *Animator) Start() {
+	if a.ReducedMotion {
+		for _, t := range a.Tracks {
+			t.Apply(1)
+		}
+		return
+	}
+
+	fyne.NewAnimation(a.Duration, func(v float32) {
+		eased := a.Easing(v)
+		for _, t := range a.Tracks {
+			t.Apply(eased)
+		}
+	}).Start()
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// --- 확장 가능한 우클릭 컨텍스트 메뉴 ---
+// TappedSecondary가 "실행/파일위치 열기/속성" 세 항목을 하드코딩하던 것을,
+// 외부 코드나 내장 기능 모두가 동일한 방식으로 항목을 등록할 수 있는 레지스트리로 바꾼다.
+
+// ScriptAction은 컨텍스트 메뉴 항목 하나를 기술한다.
+type ScriptAction struct {
+	Name      string
+	LabelFunc func(*ScriptItem) string // 설정되어 있으면 Name 대신 동적 라벨로 사용 (예: 즐겨찾기 토글)
+	Group     string                   // 비어있지 않으면 같은 Group끼리 서브메뉴로 묶인다
+	Separator bool                     // true면 이 항목 앞에 구분선을 넣는다
+	Predicate func(*ScriptItem) bool   // nil이면 항상 표시
+	Handler   func(*ScriptItem)
+}
+
+func (a ScriptAction) label(s *ScriptItem) string {
+	if a.LabelFunc != nil {
+		return a.LabelFunc(s)
+	}
+	return a.Name
+}
+
+// RegisterScriptAction은 우클릭 메뉴에 새 항목을 추가한다. 외부 코드(플러그인)도 동일하게 호출할 수 있다.
+func (l *LauncherApp) RegisterScriptAction(name string, predicate func(*ScriptItem) bool, handler func(*ScriptItem)) {
+	l.ScriptActions = append(l.ScriptActions, ScriptAction{Name: name, Predicate: predicate, Handler: handler})
+}
+
+// RegisterScriptActionInGroup은 서브메뉴로 묶일 항목을 등록한다.
+func (l *LauncherApp) RegisterScriptActionInGroup(group, name string, predicate func(*ScriptItem) bool, handler func(*ScriptItem)) {
+	l.ScriptActions = append(l.ScriptActions, ScriptAction{Group: group, Name: name, Predicate: predicate, Handler: handler})
+}
+
+// buildScriptActionMenuItems는 등록된 액션들을 predicate로 필터링하고, Group별로 서브메뉴를 구성한다.
+func (l *LauncherApp) buildScriptActionMenuItems(s *ScriptItem) []*fyne.MenuItem {
+	var items []*fyne.MenuItem
+	groups := map[string]*fyne.Menu{}
+	var groupOrder []string
+
+	for _, action := range l.ScriptActions {
+		action := action // 클로저가 루프 변수를 공유하지 않도록 루프별로 복사
+		if action.Predicate != nil && !action.Predicate(s) {
+			continue
+		}
+
+		item := fyne.NewMenuItem(action.label(s), func() {
+			action.Handler(s)
+		})
+
+		if action.Group == "" {
+			if action.Separator {
+				items = append(items, fyne.NewMenuItemSeparator())
+			}
+			items = append(items, item)
+			continue
+		}
+
+		sub, ok := groups[action.Group]
+		if !ok {
+			sub = fyne.NewMenu("")
+			groups[action.Group] = sub
+			groupOrder = append(groupOrder, action.Group)
+		}
+		if action.Separator {
+			sub.Items = append(sub.Items, fyne.NewMenuItemSeparator())
+		}
+		sub.Items = append(sub.Items, item)
+	}
+
+	for _, group := range groupOrder {
+		groupItem := fyne.NewMenuItem(group, nil)
+		groupItem.ChildMenu = groups[group]
+		items = append(items, groupItem)
+	}
+
+	return items
+}
+
+// registerBuiltinScriptActions는 PyQuickBox 내장 기능들을 같은 등록 경로로 연결한다.
+func (l *LauncherApp) registerBuiltinScriptActions() {
+	l.RegisterScriptAction("파일위치 열기", nil, func(s *ScriptItem) {
+		l.openFileLocation(*s)
+	})
+
+	l.RegisterScriptAction("경로 복사", nil, func(s *ScriptItem) {
+		l.Window.Clipboard().SetContent(s.Path)
+	})
+
+	l.RegisterScriptAction("터미널에서 열기", nil, func(s *ScriptItem) {
+		l.openTerminalHere(*s)
+	})
+
+	l.RegisterScriptAction("관리자 권한으로 실행", func(s *ScriptItem) bool {
+		return runtime.GOOS == "windows"
+	}, func(s *ScriptItem) {
+		l.runScriptAsAdmin(*s)
+	})
+
+	// 즐겨찾기 항목은 라벨이 상태에 따라 바뀌므로 LabelFunc을 사용한다
+	l.ScriptActions = append(l.ScriptActions, ScriptAction{
+		LabelFunc: func(s *ScriptItem) string {
+			if l.isFavorite(s.Path) {
+				return "즐겨찾기 제거"
+			}
+			return "즐겨찾기 추가"
+		},
+		Handler: func(s *ScriptItem) { l.toggleFavorite(s.Path) },
+	})
+
+	l.RegisterScriptAction("편집기로 열기", func(s *ScriptItem) bool {
+		return l.Editor != ""
+	}, func(s *ScriptItem) {
+		exec.Command(l.Editor, s.Path).Start()
+	})
+
+	l.RegisterScriptAction("바로가기 내보내기", nil, func(s *ScriptItem) {
+		l.showExportShortcutDialog(*s)
+	})
+
+	l.RegisterScriptAction("속성", nil, func(s *ScriptItem) {
+		l.showPropertiesDialog(*s)
+	})
+}
+
+// openTerminalHere는 스크립트 실행 없이, 스크립트가 위치한 폴더에서 셸만 띄운다.
+func (l *LauncherApp) openTerminalHere(s ScriptItem) {
+	dir := filepath.Dir(s.Path)
+
+	switch runtime.GOOS {
+	case "windows":
+		if wt := detectWindowsTerminal(); wt != "" {
+			exec.Command(wt, "-d", dir).Start()
+			return
+		}
+		cmd := exec.Command("cmd", "/C", "start", "cmd")
+		cmd.Dir = dir
+		cmd.Start()
+	case "darwin":
+		exec.Command("open", "-a", "Terminal", dir).Start()
+	default:
+		cmd := exec.Command(detectLinuxTerminal())
+		cmd.Dir = dir
+		cmd.Start()
+	}
+}
+
+// escapePowerShellSingleQuoted는 PowerShell 작은따옴표 문자열 안에 안전하게 넣을 수 있도록
+// 내장된 작은따옴표를 규칙대로 두 번 반복해 이스케이프한다.
+func escapePowerShellSingleQuoted(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// runScriptAsAdmin은 Windows에서 UAC 상승 권한으로 스크립트를 실행한다 (PowerShell Start-Process -Verb runAs).
+func (l *LauncherApp) runScriptAsAdmin(s ScriptItem) {
+	python := l.resolveInterpreter(s)
+	args := fmt.Sprintf(
+		"Start-Process -FilePath '%s' -ArgumentList '%s' -Verb runAs",
+		escapePowerShellSingleQuoted(python),
+		escapePowerShellSingleQuoted(s.Path),
+	)
+	exec.Command("powershell", "-Command", args).Start()
+}
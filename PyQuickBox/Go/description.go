@@ -0,0 +1,104 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/fsnotify/fsnotify"
+)
+
+// --- 스크립트 설명(Markdown) 패널 ---
+// 스크립트 옆에 같은 이름의 .md 파일(예: myscript.sh -> myscript.md)을 두면
+// 사용법/인자/예제를 문서화할 수 있다. 속성 다이얼로그의 "설명" 탭과
+// 메인 창의 토글형 사이드 패널에서 widget.RichText로 렌더링한다.
+// "설명" 탭은 .md 파일이 수정되면 fsnotify로 감지해 미리보기를 즉시 갱신하고,
+// 사이드 패널은 스크립트에 호버할 때마다 .md 파일을 다시 읽는다.
+
+// descriptionPathFor는 스크립트 경로에 대응하는 .md 문서 경로를 돌려준다.
+func descriptionPathFor(scriptPath string) string {
+	ext := filepath.Ext(scriptPath)
+	return strings.TrimSuffix(scriptPath, ext) + ".md"
+}
+
+func loadDescriptionMarkdown(scriptPath string) string {
+	data, err := ioutil.ReadFile(descriptionPathFor(scriptPath))
+	if err != nil {
+		return "_설명이 없습니다. 같은 이름의 .md 파일을 추가하면 여기에 표시됩니다._"
+	}
+	return string(data)
+}
+
+// updateDescriptionPane는 메인 창의 사이드 설명 패널 내용을 s로 갱신한다.
+// ScriptWidget에 마우스가 들어올 때(MouseIn)마다 호출된다.
+func (l *LauncherApp) updateDescriptionPane(s ScriptItem) {
+	if l.DescriptionPane == nil {
+		return
+	}
+	l.DescriptionPane.ParseMarkdown(loadDescriptionMarkdown(s.Path))
+}
+
+// toggleDescriptionPane은 상단 바의 설명 패널 토글 버튼에서 호출된다.
+func (l *LauncherApp) toggleDescriptionPane() {
+	if l.DescriptionPaneBox == nil {
+		return
+	}
+	l.ShowDescriptionPane = !l.ShowDescriptionPane
+	if l.ShowDescriptionPane {
+		l.DescriptionPaneBox.Show()
+	} else {
+		l.DescriptionPaneBox.Hide()
+	}
+}
+
+// newDescriptionTab은 속성 다이얼로그의 "설명" 탭 내용을 만든다.
+// 다이얼로그가 열려 있는 동안 .md 파일의 변경을 감지해 미리보기를 실시간으로 갱신하고,
+// stop 함수를 반환하므로 다이얼로그가 닫힐 때 watcher를 정리할 수 있다.
+func newDescriptionTab(s ScriptItem) (fyne.CanvasObject, func()) {
+	richText := widget.NewRichTextFromMarkdown(loadDescriptionMarkdown(s.Path))
+	richText.Wrapping = fyne.TextWrapWord
+
+	scroll := container.NewVScroll(richText)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return scroll, func() {}
+	}
+	watcher.Add(filepath.Dir(s.Path))
+
+	mdPath := descriptionPathFor(s.Path)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Name == mdPath {
+					// fsnotify 콜백은 백그라운드 고루틴에서 실행되므로, 열려 있는
+					// 위젯을 직접 건드리지 않고 fyne.Do로 UI 고루틴에 넘긴다.
+					fyne.Do(func() {
+						richText.ParseMarkdown(loadDescriptionMarkdown(s.Path))
+					})
+				}
+			case <-watcher.Errors:
+				watcher.Close()
+				return
+			case <-done:
+				watcher.Close()
+				return
+			}
+		}
+	}()
+
+	stop := func() {
+		close(done)
+	}
+
+	return scroll, stop
+}
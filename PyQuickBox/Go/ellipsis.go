@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// --- 말줄임표 / 잘린 라벨 호버 툴팁 ---
+// wrapSmart가 MaxLines를 넘는 줄을 그냥 버리던 것을, 실제로 잘렸다는 것을
+// "…"로 보여주고, 잘린 전체 이름은 호버 시 팝업으로 보여주도록 확장한다.
+
+// EllipsisMode는 말줄임표를 어디에 넣을지 결정한다.
+type EllipsisMode int
+
+const (
+	EllipsisNone EllipsisMode = iota
+	EllipsisTail
+	EllipsisMiddle
+)
+
+const ellipsisChar = "…"
+
+// applyEllipsis는 잘린 마지막 줄에 대해 measured width를 기준으로 "…"를 붙인다.
+// Tail: 끝에서부터 글자를 줄여가며 "text…"가 maxWidth에 들어갈 때까지 자른다.
+// Middle: 앞뒤를 보존하고 가운데를 줄여가며 "앞…뒤"가 들어갈 때까지 자른다.
+func applyEllipsis(lines []string, size, maxWidth float32, mode EllipsisMode) []string {
+	if mode == EllipsisNone || len(lines) == 0 {
+		return lines
+	}
+
+	style := fyne.TextStyle{}
+	last := []rune(lines[len(lines)-1])
+
+	switch mode {
+	case EllipsisTail:
+		for len(last) > 0 {
+			candidate := string(last) + ellipsisChar
+			if fyne.MeasureText(candidate, size, style).Width <= maxWidth {
+				lines[len(lines)-1] = candidate
+				return lines
+			}
+			last = last[:len(last)-1]
+		}
+		lines[len(lines)-1] = ellipsisChar
+
+	case EllipsisMiddle:
+		head, tail := len(last)/2, len(last)/2
+		for head > 0 || tail > 0 {
+			candidate := string(last[:head]) + ellipsisChar + string(last[len(last)-tail:])
+			if fyne.MeasureText(candidate, size, style).Width <= maxWidth {
+				lines[len(lines)-1] = candidate
+				return lines
+			}
+			if head > tail {
+				head--
+			} else if tail > 0 {
+				tail--
+			} else {
+				head--
+			}
+		}
+		lines[len(lines)-1] = ellipsisChar
+	}
+
+	return lines
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// --- 최근 사용 / 즐겨찾기 / 사용 빈도 기반 정렬 ---
+// 스크립트별 실행 횟수와 마지막 실행 시각을 preferences에 기록하고,
+// 사이드바의 "Recent"/"Favorites" 가상 섹션과 상단 바의 정렬 모드 선택에 사용한다.
+
+const (
+	KeyUsageStats = "UsageStats"
+	KeyFavorites  = "Favorites"
+	KeySortMode   = "SortMode"
+)
+
+const (
+	SortByName          = "Name"
+	SortByMostUsed      = "MostUsed"
+	SortByRecentlyUsed  = "RecentlyUsed"
+	SortByRecentlyAdded = "RecentlyAdded"
+)
+
+var SortModes = []string{SortByName, SortByMostUsed, SortByRecentlyUsed, SortByRecentlyAdded}
+
+// UsageStat은 경로 하나에 대한 실행 기록이다.
+type UsageStat struct {
+	Count   int   `json:"count"`
+	LastRun int64 `json:"lastRun"` // Unix epoch seconds
+}
+
+func (l *LauncherApp) loadUsagePreferences() {
+	l.UsageStats = make(map[string]UsageStat)
+	if raw := l.App.Preferences().String(KeyUsageStats); raw != "" {
+		json.Unmarshal([]byte(raw), &l.UsageStats)
+	}
+
+	l.Favorites = make(map[string]bool)
+	var favList []string
+	if raw := l.App.Preferences().String(KeyFavorites); raw != "" {
+		json.Unmarshal([]byte(raw), &favList)
+	}
+	for _, p := range favList {
+		l.Favorites[p] = true
+	}
+
+	l.SortMode = l.App.Preferences().StringWithFallback(KeySortMode, SortByName)
+}
+
+func (l *LauncherApp) saveUsagePreferences() {
+	if data, err := json.Marshal(l.UsageStats); err == nil {
+		l.App.Preferences().SetString(KeyUsageStats, string(data))
+	}
+
+	favList := make([]string, 0, len(l.Favorites))
+	for p, on := range l.Favorites {
+		if on {
+			favList = append(favList, p)
+		}
+	}
+	if data, err := json.Marshal(favList); err == nil {
+		l.App.Preferences().SetString(KeyFavorites, string(data))
+	}
+
+	l.App.Preferences().SetString(KeySortMode, l.SortMode)
+}
+
+// recordLaunch는 스크립트가 실행될 때마다 runScript에서 호출되어 사용 기록을 갱신한다.
+func (l *LauncherApp) recordLaunch(path string, now int64) {
+	stat := l.UsageStats[path]
+	stat.Count++
+	stat.LastRun = now
+	l.UsageStats[path] = stat
+	l.saveUsagePreferences()
+}
+
+// toggleFavorite은 우클릭 메뉴의 "즐겨찾기 추가/제거"에서 호출된다.
+func (l *LauncherApp) toggleFavorite(path string) {
+	if l.Favorites[path] {
+		delete(l.Favorites, path)
+	} else {
+		l.Favorites[path] = true
+	}
+	l.saveUsagePreferences()
+	l.updateGridUI()
+}
+
+func (l *LauncherApp) isFavorite(path string) bool {
+	return l.Favorites[path]
+}
+
+// sortDisplayScripts는 현재 선택된 SortMode에 따라 scripts를 제자리 정렬한다.
+func (l *LauncherApp) sortDisplayScripts(scripts []ScriptItem) {
+	switch l.SortMode {
+	case SortByMostUsed:
+		sort.Slice(scripts, func(i, j int) bool {
+			return l.UsageStats[scripts[i].Path].Count > l.UsageStats[scripts[j].Path].Count
+		})
+	case SortByRecentlyUsed:
+		sort.Slice(scripts, func(i, j int) bool {
+			return l.UsageStats[scripts[i].Path].LastRun > l.UsageStats[scripts[j].Path].LastRun
+		})
+	case SortByRecentlyAdded:
+		sort.Slice(scripts, func(i, j int) bool {
+			return scripts[i].ModTime.After(scripts[j].ModTime)
+		})
+	default: // SortByName
+		sort.Slice(scripts, func(i, j int) bool {
+			return strings.ToLower(scripts[i].Name) < strings.ToLower(scripts[j].Name)
+		})
+	}
+}
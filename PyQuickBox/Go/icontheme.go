@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// --- Freedesktop 아이콘 테마 조회 ---
+// index.theme를 파싱해서 디렉터리/크기/스케일/컨텍스트 인덱스를 만들고,
+// 표준 FindIcon 알고리즘(정확한 크기 -> Min/Max/Threshold -> 부모 테마 -> hicolor -> pixmaps)을 구현한다.
+// Windows/macOS에서는 테마가 없으므로 파일 기반 조회로 자연히 degrade된다.
+
+type iconThemeDir struct {
+	Path      string
+	Size      int
+	MinSize   int
+	MaxSize   int
+	Threshold int
+	Scale     int
+	Context   string
+	Type      string // Fixed, Scalable, Threshold
+}
+
+// IconTheme는 하나의 index.theme 내용을 담는다.
+type IconTheme struct {
+	Name        string
+	BaseDir     string // .../icons/<Name>
+	Inherits    []string
+	Directories []iconThemeDir
+}
+
+// IconThemeIndex는 시스템에서 찾은 모든 테마를 이름으로 보관한다.
+type IconThemeIndex struct {
+	Themes map[string]*IconTheme
+}
+
+func xdgDataDirs() []string {
+	dirs := []string{}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".icons"))
+	}
+	if v := os.Getenv("XDG_DATA_DIRS"); v != "" {
+		for _, d := range strings.Split(v, ":") {
+			dirs = append(dirs, filepath.Join(d, "icons"))
+		}
+	} else {
+		dirs = append(dirs, "/usr/local/share/icons", "/usr/share/icons")
+	}
+	dirs = append(dirs, "/usr/share/pixmaps")
+	return dirs
+}
+
+// BuildIconThemeIndex는 $XDG_DATA_DIRS/icons와 ~/.icons 아래의 모든 테마를 스캔한다.
+func BuildIconThemeIndex() *IconThemeIndex {
+	idx := &IconThemeIndex{Themes: make(map[string]*IconTheme)}
+	if runtime.GOOS != "linux" {
+		return idx
+	}
+
+	for _, base := range xdgDataDirs() {
+		entries, err := os.ReadDir(base)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if !e.IsDir() {
+				continue
+			}
+			themeDir := filepath.Join(base, e.Name())
+			indexPath := filepath.Join(themeDir, "index.theme")
+			theme, err := parseIndexTheme(indexPath, themeDir)
+			if err != nil {
+				continue
+			}
+			if existing, ok := idx.Themes[theme.Name]; !ok || existing == nil {
+				idx.Themes[theme.Name] = theme
+			}
+		}
+	}
+	return idx
+}
+
+func parseIndexTheme(path, baseDir string) (*IconTheme, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	theme := &IconTheme{Name: filepath.Base(baseDir), BaseDir: baseDir}
+
+	var dirNames []string
+	section := ""
+	cur := iconThemeDir{Size: 48, Type: "Threshold", Threshold: 2, Scale: 1}
+	curName := ""
+
+	flush := func() {
+		if curName != "" {
+			cur.Path = curName
+			if cur.MinSize == 0 {
+				cur.MinSize = cur.Size
+			}
+			if cur.MaxSize == 0 {
+				cur.MaxSize = cur.Size
+			}
+			theme.Directories = append(theme.Directories, cur)
+		}
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			flush()
+			section = strings.Trim(line, "[]")
+			if section != "Icon Theme" {
+				dirNames = append(dirNames, section)
+				curName = section
+				cur = iconThemeDir{Size: 48, Type: "Threshold", Threshold: 2, Scale: 1}
+			} else {
+				curName = ""
+			}
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key, val := kv[0], kv[1]
+
+		if section == "Icon Theme" {
+			switch key {
+			case "Inherits":
+				theme.Inherits = strings.Split(val, ",")
+			}
+			continue
+		}
+
+		switch key {
+		case "Size":
+			cur.Size, _ = strconv.Atoi(val)
+		case "MinSize":
+			cur.MinSize, _ = strconv.Atoi(val)
+		case "MaxSize":
+			cur.MaxSize, _ = strconv.Atoi(val)
+		case "Threshold":
+			cur.Threshold, _ = strconv.Atoi(val)
+		case "Scale":
+			cur.Scale, _ = strconv.Atoi(val)
+		case "Context":
+			cur.Context = val
+		case "Type":
+			cur.Type = val
+		}
+	}
+	flush()
+
+	return theme, nil
+}
+
+func dirMatchesSize(d iconThemeDir, size, scale int) bool {
+	if d.Scale != 0 && scale != 0 && d.Scale != scale {
+		return false
+	}
+	switch d.Type {
+	case "Fixed":
+		return d.Size == size
+	case "Scalable":
+		return d.MinSize <= size && size <= d.MaxSize
+	default: // Threshold
+		return size >= d.Size-d.Threshold && size <= d.Size+d.Threshold
+	}
+}
+
+func (idx *IconThemeIndex) lookupInTheme(theme *IconTheme, name string, size, scale int, visited map[string]bool) string {
+	if theme == nil || visited[theme.Name] {
+		return ""
+	}
+	visited[theme.Name] = true
+
+	exts := []string{".png", ".svg", ".xpm"}
+
+	// 1. 크기 매치 (Fixed는 정확한 크기, Scalable은 Min/Max, Threshold는 Size±Threshold)
+	for _, d := range theme.Directories {
+		if !dirMatchesSize(d, size, scale) {
+			continue
+		}
+		for _, ext := range exts {
+			p := filepath.Join(theme.BaseDir, d.Path, name+ext)
+			if _, err := os.Stat(p); err == nil {
+				return p
+			}
+		}
+	}
+
+	// 2. 아이콘이 실제로 있는 디렉터리 중 가장 가까운 크기(Min/Max/Threshold 범위를 벗어나더라도 최소 오차)로 재시도
+	bestDist := -1
+	bestPath := ""
+	for _, d := range theme.Directories {
+		dist := iconSizeDistance(d, size)
+		for _, ext := range exts {
+			p := filepath.Join(theme.BaseDir, d.Path, name+ext)
+			if _, err := os.Stat(p); err == nil && (bestDist == -1 || dist < bestDist) {
+				bestDist = dist
+				bestPath = p
+			}
+		}
+	}
+	if bestPath != "" {
+		return bestPath
+	}
+
+	// 3. 부모 테마로 폴백
+	for _, parent := range theme.Inherits {
+		parent = strings.TrimSpace(parent)
+		if p := idx.lookupInTheme(idx.Themes[parent], name, size, scale, visited); p != "" {
+			return p
+		}
+	}
+
+	return ""
+}
+
+func iconSizeDistance(d iconThemeDir, size int) int {
+	if size < d.MinSize {
+		return d.MinSize - size
+	}
+	if size > d.MaxSize {
+		return size - d.MaxSize
+	}
+	return 0
+}
+
+// FindIcon은 freedesktop Icon Theme Specification의 표준 탐색 알고리즘을 구현한다:
+// preferredTheme -> hicolor -> /usr/share/pixmaps 순으로 폴백한다.
+func (idx *IconThemeIndex) FindIcon(name, preferredTheme string, size, scale int) string {
+	if name == "" {
+		return ""
+	}
+
+	order := []string{}
+	if preferredTheme != "" {
+		order = append(order, preferredTheme)
+	}
+	order = append(order, "hicolor")
+
+	for _, themeName := range order {
+		if p := idx.lookupInTheme(idx.Themes[themeName], name, size, scale, map[string]bool{}); p != "" {
+			return p
+		}
+	}
+
+	// 언테마 아이콘(/usr/share/pixmaps 등)
+	for _, ext := range []string{".png", ".svg", ".xpm"} {
+		p := filepath.Join("/usr/share/pixmaps", name+ext)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+
+	return ""
+}
+
+// ThemeNames는 설정 다이얼로그의 테마 선택 목록에 쓰인다.
+func (idx *IconThemeIndex) ThemeNames() []string {
+	names := make([]string, 0, len(idx.Themes))
+	for n := range idx.Themes {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// --- 단일 인스턴스 IPC ---
+// go-singleinstance와 비슷한 방식: 소켓(Windows는 로컬 루프백 TCP 포트) 하나를 선점하고,
+// 이미 떠 있는 인스턴스가 있으면 인자만 던져주고 현재 프로세스는 종료한다.
+// Windows named pipe는 표준 net 패키지가 지원하지 않고(go-winio 등 추가 의존성이 필요)
+// 이 트리에는 그런 의존성이 없으므로, 플랫폼 공통으로 net이 실제로 지원하는 전송을 쓴다.
+
+// IPCCommand는 --run/--refresh/--focus/--add-folder 등 외부에서 들어온 제어 명령이다.
+type IPCCommand struct {
+	Cmd string `json:"cmd"`
+	Arg string `json:"arg"`
+}
+
+func ipcAddress() string {
+	if runtime.GOOS == "windows" {
+		// net은 named pipe를 지원하지 않으므로 로컬 루프백 고정 포트를 사용한다.
+		return "127.0.0.1:49237"
+	}
+	return filepath.Join(os.TempDir(), "pyquickbox.sock")
+}
+
+func ipcNetwork() string {
+	if runtime.GOOS == "windows" {
+		return "tcp"
+	}
+	return "unix"
+}
+
+// startIPCServer는 소켓(Windows는 루프백 TCP 포트)을 선점하고, 들어오는 명령을 cmds 채널로 흘려보낸다.
+// 이미 다른 인스턴스가 선점하고 있으면 에러를 돌려준다(그 경우 forwardArgsToRunningInstance가 대신 처리함).
+func startIPCServer(cmds chan<- IPCCommand) error {
+	network, address := ipcNetwork(), ipcAddress()
+
+	if network == "unix" {
+		// 이전 비정상 종료로 남은 소켓 파일 정리 시도
+		if _, err := net.Dial(network, address); err != nil {
+			os.Remove(address)
+		}
+	}
+
+	ln, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go handleIPCConn(conn, cmds)
+		}
+	}()
+
+	return nil
+}
+
+func handleIPCConn(conn net.Conn, cmds chan<- IPCCommand) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var cmd IPCCommand
+		if err := json.Unmarshal(scanner.Bytes(), &cmd); err == nil {
+			cmds <- cmd
+		}
+	}
+}
+
+// forwardArgsToRunningInstance는 이미 실행 중인 인스턴스가 있는지 확인하고,
+// 있다면 커맨드라인 인자를 넘긴 뒤 true를 반환한다(호출자는 바로 종료하면 됨).
+func forwardArgsToRunningInstance(args []string) bool {
+	conn, err := net.DialTimeout(ipcNetwork(), ipcAddress(), 300*time.Millisecond)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	for _, cmd := range parseIPCArgs(args) {
+		data, err := json.Marshal(cmd)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(conn, "%s\n", data)
+	}
+	return true
+}
+
+// parseIPCArgs는 "--run foo --refresh --focus --add-folder /path"류 인자를 IPCCommand로 변환한다.
+func parseIPCArgs(args []string) []IPCCommand {
+	var cmds []IPCCommand
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--run":
+			if i+1 < len(args) {
+				cmds = append(cmds, IPCCommand{Cmd: "run", Arg: args[i+1]})
+				i++
+			}
+		case "--refresh":
+			cmds = append(cmds, IPCCommand{Cmd: "refresh"})
+		case "--focus":
+			cmds = append(cmds, IPCCommand{Cmd: "focus"})
+		case "--add-folder":
+			if i+1 < len(args) {
+				cmds = append(cmds, IPCCommand{Cmd: "add-folder", Arg: args[i+1]})
+				i++
+			}
+		}
+	}
+	return cmds
+}
+
+// watchIPCCommands는 백그라운드 고루틴에서 들어온 명령을 받아, 실제 UI 갱신은
+// fyne.Do로 UI(메인) 고루틴에 넘겨서 적용한다. 외부 프로세스가 임의 시점에 보내는
+// 명령이 위젯 목록을 직접 건드리게 두면 안전하지 않으므로, watchFolders와 달리
+// 여기서는 직접 호출하지 않는다.
+func (l *LauncherApp) watchIPCCommands() {
+	if l.IPCCmds == nil {
+		return
+	}
+	for cmd := range l.IPCCmds {
+		cmd := cmd
+		fyne.Do(func() {
+			switch cmd.Cmd {
+			case "run":
+				if s, ok := l.findScriptByName(cmd.Arg); ok {
+					l.runScript(s)
+				}
+			case "refresh":
+				l.refreshScripts()
+			case "focus":
+				l.Window.RequestFocus()
+			case "add-folder":
+				l.addRegisteredFolder(cmd.Arg)
+			}
+		})
+	}
+}
@@ -37,6 +37,8 @@ type ScriptItem struct {
 	InterpWin       string // #pqr win
 	InterpUbuntu    string // #pqr ubuntu
 	Terminal        bool   // #pqr terminal true
+	IconName        string // #pqr icon "themed-icon-name"
+	ModTime         time.Time
 }
 
 // --- 앱 설정 키 ---
@@ -45,6 +47,10 @@ const (
 	KeyPythonPath        = "PythonPath"
 	KeyIconSize          = "IconSize"
 	KeyFontSize          = "FontSize"
+	KeyIconTheme         = "IconTheme"
+	KeyTerminalTemplate  = "TerminalTemplate"
+	KeyKeepTerminalOpen  = "KeepTerminalOpen"
+	KeyEditor            = "Editor"
 )
 
 // --- 메인 구조체 ---
@@ -59,9 +65,22 @@ type LauncherApp struct {
 	RegisteredFolders []string
 
 	// 설정
-	DefaultPythonPath string
-	IconSize          float32
-	FontSize          float32
+	DefaultPythonPath   string
+	IconSize            float32
+	FontSize            float32
+	PreferredIconTheme  string
+	IconThemeIdx        *IconThemeIndex
+	TerminalTemplate    string // 예: "alacritty -e {cmd}" ({cmd}/{interp}/{script}/{cwd} 치환)
+	KeepTerminalOpen    bool
+
+	// 최근 사용 / 즐겨찾기 / 정렬
+	UsageStats map[string]UsageStat
+	Favorites  map[string]bool
+	SortMode   string
+
+	// 우클릭 컨텍스트 메뉴 확장
+	ScriptActions []ScriptAction
+	Editor        string // 설정된 경우 "편집기로 열기" 메뉴 활성화
 
 	// 검색
 	SearchText  string
@@ -70,15 +89,31 @@ type LauncherApp struct {
 	// 파일 감지
 	Watcher *fsnotify.Watcher
 
+	// 다중 실행 방지 / 외부 제어
+	IPCCmds chan IPCCommand
+
 	// UI State
 	CurrentCategory string
 	Sidebar         *widget.List
 	SidebarVisible  bool
 	MainContent     *fyne.Container // 우측 컨텐츠 영역 참조 유지
 	TopBar          *fyne.Container
+
+	// 설명(.md) 사이드 패널
+	ShowDescriptionPane bool
+	DescriptionPane     *widget.RichText
+	DescriptionPaneBox  fyne.CanvasObject
+
+	// 접근성
+	Accessibility AccessibilitySettings
 }
 
 func main() {
+	// 0. 이미 실행 중인 인스턴스가 있으면 인자만 넘기고 종료
+	if forwardArgsToRunningInstance(os.Args[1:]) {
+		return
+	}
+
 	myApp := app.NewWithID("com.pyquickbox.linux")
 	myWindow := myApp.NewWindow("PyQuickBox v1.0.0")
 
@@ -88,10 +123,15 @@ func main() {
 		Scripts:  make(map[string][]ScriptItem),
 		IconSize: 80, // 기본값
 		FontSize: 12, // 기본값
+		IPCCmds:  make(chan IPCCommand, 8),
 	}
 
 	// 1. 설정 불러오기
 	launcher.loadPreferences()
+	launcher.loadUsagePreferences()
+	launcher.loadAccessibilityPreferences()
+	launcher.IconThemeIdx = BuildIconThemeIndex()
+	launcher.registerBuiltinScriptActions()
 
 	// 2. 파일 감지기 시작
 	watcher, err := fsnotify.NewWatcher()
@@ -100,6 +140,12 @@ func main() {
 		go launcher.watchFolders()
 	}
 
+	// 2.5 단일 인스턴스 서버 시작 (이후 실행은 이 인스턴스로 전달됨)
+	if err := startIPCServer(launcher.IPCCmds); err != nil {
+		fmt.Printf("IPC server not started: %v\n", err)
+	}
+	go launcher.watchIPCCommands()
+
 	// 3. UI 구성
 	launcher.setupUI()
 
@@ -113,10 +159,12 @@ func main() {
 // --- UI 구성 ---
 func (l *LauncherApp) setupUI() {
 	// 1. Sidebar (좌측)
+	// 가상 섹션(All Apps/Recent/Favorites) + 실제 카테고리
+	virtualSections := []string{"All Apps", "Recent", "Favorites"}
+
 	l.Sidebar = widget.NewList(
 		func() int {
-			// All Apps + Categories
-			return 1 + len(l.Categories)
+			return len(virtualSections) + len(l.Categories)
 		},
 		func() fyne.CanvasObject {
 			return container.NewHBox(widget.NewIcon(theme.FolderIcon()), widget.NewLabel("Template"))
@@ -126,15 +174,20 @@ func (l *LauncherApp) setupUI() {
 			icon := hbox.Objects[0].(*widget.Icon)
 			label := hbox.Objects[1].(*widget.Label)
 
-			if i == 0 { // Item: All Apps
-				icon.SetResource(theme.GridIcon())
-				label.SetText("All Apps")
-				label.TextStyle = fyne.TextStyle{Bold: true} // Make All Apps bold for distinction
+			if i < len(virtualSections) { // Item: All Apps / Recent / Favorites
+				switch virtualSections[i] {
+				case "All Apps":
+					icon.SetResource(theme.GridIcon())
+				default:
+					icon.SetResource(theme.FolderIcon())
+				}
+				label.SetText(virtualSections[i])
+				label.TextStyle = fyne.TextStyle{Bold: true} // 가상 섹션은 구분을 위해 Bold
 				return
 			}
-			
+
 			// Categories
-			catIndex := i - 1
+			catIndex := i - len(virtualSections)
 			if catIndex >= 0 && catIndex < len(l.Categories) {
 				icon.SetResource(theme.FolderIcon())
 				label.SetText(l.Categories[catIndex])
@@ -142,12 +195,19 @@ func (l *LauncherApp) setupUI() {
 			}
 		},
 	)
-	
+
 	l.Sidebar.OnSelected = func(id widget.ListItemID) {
-		if id == 0 {
-			l.CurrentCategory = "All"
+		if int(id) < len(virtualSections) {
+			switch virtualSections[id] {
+			case "All Apps":
+				l.CurrentCategory = "All"
+			case "Recent":
+				l.CurrentCategory = "Recent"
+			case "Favorites":
+				l.CurrentCategory = "Favorites"
+			}
 		} else {
-			catIndex := id - 1
+			catIndex := int(id) - len(virtualSections)
 			if catIndex >= 0 && catIndex < len(l.Categories) {
 				l.CurrentCategory = l.Categories[catIndex]
 			}
@@ -195,22 +255,45 @@ func (l *LauncherApp) setupUI() {
 		l.showSettingsDialog()
 	})
 
+	// 정렬 모드 선택 (이름순 / 많이 사용 / 최근 사용 / 최근 추가)
+	sortSelect := widget.NewSelect(SortModes, func(mode string) {
+		l.SortMode = mode
+		l.saveUsagePreferences()
+		l.updateGridUI()
+	})
+	sortSelect.SetSelected(l.SortMode)
+	sortContainer := container.NewGridWrap(fyne.NewSize(140, 34), sortSelect)
+
+	descToggleBtn := widget.NewButtonWithIcon("", theme.InfoIcon(), func() {
+		l.toggleDescriptionPane()
+	})
+
 	topRightControls := container.NewHBox(
 		widget.NewIcon(theme.SearchIcon()), searchContainer,
 		widget.NewIcon(theme.GridIcon()), sliderContainer,
+		sortContainer,
+		descToggleBtn,
 		settingsBtn,
 	)
-	
+
 	// titleLabel 왼쪽에 toggleBtn 배치
 	topLeftControls := container.NewHBox(toggleBtn, titleLabel)
-	
+
 	l.TopBar = container.NewBorder(nil, nil, topLeftControls, topRightControls)
 
 	// 3. Main Content (우측)
 	l.ContentBox = container.NewVBox()
 	scrollArea := container.NewVScroll(l.ContentBox)
-	
-	l.MainContent = container.NewBorder(container.NewPadded(l.TopBar), nil, nil, nil, container.NewPadded(scrollArea))
+
+	// 호버한 스크립트의 설명(.md)을 보여주는 토글형 사이드 패널
+	l.DescriptionPane = widget.NewRichTextFromMarkdown("")
+	l.DescriptionPane.Wrapping = fyne.TextWrapWord
+	descScroll := container.NewVScroll(l.DescriptionPane)
+	descScroll.SetMinSize(fyne.NewSize(220, 0))
+	l.DescriptionPaneBox = descScroll
+	l.DescriptionPaneBox.Hide()
+
+	l.MainContent = container.NewBorder(container.NewPadded(l.TopBar), nil, nil, l.DescriptionPaneBox, container.NewPadded(scrollArea))
 
 	// 4. 초기 상태 설정 및 레이아웃 적용
 	l.CurrentCategory = "All"
@@ -240,20 +323,34 @@ func (l *LauncherApp) updateGridUI() {
 	// 표시할 스크립트 목록 수집
 	var displayScripts []ScriptItem
 
-	if l.CurrentCategory == "All" || l.CurrentCategory == "" {
+	switch l.CurrentCategory {
+	case "All", "":
 		// 모든 카테고리 보기
 		for _, scripts := range l.Scripts {
 			displayScripts = append(displayScripts, scripts...)
 		}
-	} else {
+	case "Recent":
+		for _, scripts := range l.Scripts {
+			for _, s := range scripts {
+				if _, ok := l.UsageStats[s.Path]; ok {
+					displayScripts = append(displayScripts, s)
+				}
+			}
+		}
+	case "Favorites":
+		for _, scripts := range l.Scripts {
+			for _, s := range scripts {
+				if l.Favorites[s.Path] {
+					displayScripts = append(displayScripts, s)
+				}
+			}
+		}
+	default:
 		// 특정 카테고리 보기
 		displayScripts = l.Scripts[l.CurrentCategory]
 	}
 
-	// 정렬 (이름순)
-	sort.Slice(displayScripts, func(i, j int) bool {
-		return strings.ToLower(displayScripts[i].Name) < strings.ToLower(displayScripts[j].Name)
-	})
+	l.sortDisplayScripts(displayScripts)
 
 	// 검색어 필터링
 	var filteredScripts []ScriptItem
@@ -329,9 +426,11 @@ func (l *LauncherApp) refreshScripts() {
 		iconFolder := filepath.Join(folder, "icon")
 
 		for _, file := range files {
-			if filepath.Ext(file.Name()) == ".py" {
+			ext := filepath.Ext(file.Name())
+			runner := runnerFor(ext)
+			if runner != nil {
 				fullPath := filepath.Join(folder, file.Name())
-				fileName := strings.TrimSuffix(file.Name(), ".py")
+				fileName := strings.TrimSuffix(file.Name(), ext)
 
 				// 아이콘 찾기
 				var iconPath string
@@ -344,19 +443,31 @@ func (l *LauncherApp) refreshScripts() {
 					iconPath = defaultIcon
 				}
 
-				// 파싱
-				cat, iMac, iWin, iUbu, term, iDef := l.parseHeader(fullPath)
+				// 파싱 (언어별 헤더 태그 사용, 예: .js는 //pqr)
+				cat, iMac, iWin, iUbu, term, iDef, iconName := l.parseHeader(fullPath, runner.HeaderTag())
+
+				// 셔뱅(#!)이 있으면 기본 인터프리터를 덮어씀
+				if sheBang := parseShebang(fullPath); sheBang != "" {
+					iDef = sheBang
+				}
+
+				// 파일 기반 아이콘이 없고 테마 아이콘 이름이 지정된 경우, 프리덱스탑 아이콘 테마에서 조회
+				if iconPath == "" && iconName != "" {
+					iconPath = l.IconThemeIdx.FindIcon(iconName, l.PreferredIconTheme, int(l.IconSize), 1)
+				}
 
 				item := ScriptItem{
 					Name:          fileName,
 					Path:          fullPath,
 					Category:      cat,
 					IconPath:      iconPath,
+					IconName:      iconName,
 					InterpMac:     iMac,
 					InterpWin:     iWin,
 					InterpUbuntu:  iUbu,
 					Terminal:      term,
 					InterpDefault: iDef,
+					ModTime:       file.ModTime(),
 				}
 
 				newScripts[cat] = append(newScripts[cat], item)
@@ -402,18 +513,20 @@ func (l *LauncherApp) refreshScripts() {
 }
 
 // 파일 헤더 파싱 (#pqr)
-func (l *LauncherApp) parseHeader(path string) (string, string, string, string, bool, string) {
+func (l *LauncherApp) parseHeader(path, tag string) (string, string, string, string, bool, string, string) {
 	file, err := os.Open(path)
 	if err != nil {
-		return "Uncategorized", "", "", "", false, ""
+		return "Uncategorized", "", "", "", false, "", ""
 	}
 	defer file.Close()
 
 	scanner := bufio.NewScanner(file)
 	category := "Uncategorized"
-	var interpDefault, interpMac, interpWin, interpUbuntu string
+	var interpDefault, interpMac, interpWin, interpUbuntu, iconName string
 	var terminal bool
 
+	qtag := regexp.QuoteMeta(tag)
+
 	lineCount := 0
 	for scanner.Scan() {
 		if lineCount > 15 { // 헤더 파싱 범위 약간 늘림
@@ -421,58 +534,68 @@ func (l *LauncherApp) parseHeader(path string) (string, string, string, string,
 		}
 		line := strings.TrimSpace(scanner.Text())
 
-		// #pqr cat "Category"
-		if strings.HasPrefix(line, "#pqr cat") {
-			re := regexp.MustCompile(`#pqr\s+cat\s+"([^"]+)"`)
+		// <tag> cat "Category"
+		if strings.HasPrefix(line, tag+" cat") {
+			re := regexp.MustCompile(qtag + `\s+cat\s+"([^"]+)"`)
 			matches := re.FindStringSubmatch(line)
 			if len(matches) > 1 {
 				category = matches[1]
 			}
 		}
 
-		// #pqr mac "Path"
-		if strings.HasPrefix(line, "#pqr mac") {
-			re := regexp.MustCompile(`#pqr\s+mac\s+"([^"]+)"`)
+		// <tag> mac "Path"
+		if strings.HasPrefix(line, tag+" mac") {
+			re := regexp.MustCompile(qtag + `\s+mac\s+"([^"]+)"`)
 			matches := re.FindStringSubmatch(line)
 			if len(matches) > 1 {
 				interpMac = matches[1]
 			}
 		}
 
-		// #pqr win "Path"
-		if strings.HasPrefix(line, "#pqr win") {
-			re := regexp.MustCompile(`#pqr\s+win\s+"([^"]+)"`)
+		// <tag> win "Path"
+		if strings.HasPrefix(line, tag+" win") {
+			re := regexp.MustCompile(qtag + `\s+win\s+"([^"]+)"`)
 			matches := re.FindStringSubmatch(line)
 			if len(matches) > 1 {
 				interpWin = matches[1]
 			}
 		}
 
-		// #pqr ubuntu "Path"
-		if strings.HasPrefix(line, "#pqr ubuntu") {
-			re := regexp.MustCompile(`#pqr\s+ubuntu\s+"([^"]+)"`)
+		// <tag> ubuntu "Path"
+		if strings.HasPrefix(line, tag+" ubuntu") {
+			re := regexp.MustCompile(qtag + `\s+ubuntu\s+"([^"]+)"`)
 			matches := re.FindStringSubmatch(line)
 			if len(matches) > 1 {
 				interpUbuntu = matches[1]
 			}
 		}
 
-		// #pqr terminal true
-		if strings.HasPrefix(line, "#pqr terminal") {
+		// <tag> terminal true
+		if strings.HasPrefix(line, tag+" terminal") {
 			if strings.Contains(line, "true") {
 				terminal = true
 			}
 		}
 
-		// Legacy: #pqr linux ... or simple #pqr "Cat" "Interp"
-		if strings.HasPrefix(line, "#pqr") && 
-			!strings.HasPrefix(line, "#pqr cat") &&
-			!strings.HasPrefix(line, "#pqr mac") &&
-			!strings.HasPrefix(line, "#pqr win") &&
-			!strings.HasPrefix(line, "#pqr ubuntu") &&
-			!strings.HasPrefix(line, "#pqr terminal") {
-			
-			re := regexp.MustCompile(`#pqr\s+\w+.*"([^"]+)"\s*(.*)`)
+		// <tag> icon "themed-icon-name"
+		if strings.HasPrefix(line, tag+" icon") {
+			re := regexp.MustCompile(qtag + `\s+icon\s+"([^"]+)"`)
+			matches := re.FindStringSubmatch(line)
+			if len(matches) > 1 {
+				iconName = matches[1]
+			}
+		}
+
+		// Legacy: <tag> linux ... or simple <tag> "Cat" "Interp"
+		if strings.HasPrefix(line, tag) &&
+			!strings.HasPrefix(line, tag+" cat") &&
+			!strings.HasPrefix(line, tag+" mac") &&
+			!strings.HasPrefix(line, tag+" win") &&
+			!strings.HasPrefix(line, tag+" ubuntu") &&
+			!strings.HasPrefix(line, tag+" terminal") &&
+			!strings.HasPrefix(line, tag+" icon") {
+
+			re := regexp.MustCompile(qtag + `\s+\w+.*"([^"]+)"\s*(.*)`)
 			matches := re.FindStringSubmatch(line)
 			if len(matches) > 1 {
 				// 이미 카테고리가 설정되지 않았다면 (우선순위를 cat 태그에 둠)
@@ -486,44 +609,52 @@ func (l *LauncherApp) parseHeader(path string) (string, string, string, string,
 		}
 		lineCount++
 	}
-	return category, interpMac, interpWin, interpUbuntu, terminal, interpDefault
+	return category, interpMac, interpWin, interpUbuntu, terminal, interpDefault, iconName
 }
 
 // --- 로직: 실행 ---
-func (l *LauncherApp) runScript(s ScriptItem) {
-	var python string
+
+// resolveInterpreter는 OS별 태그 -> Default(Legacy/셔뱅) -> (.py 한정) 앱 설정 기본값
+// -> 확장자별 Runner 기본값 순으로 인터프리터를 결정한다. runScript와 바로가기 내보내기가 공유한다.
+func (l *LauncherApp) resolveInterpreter(s ScriptItem) string {
+	var interp string
 
 	// OS별 인터프리터 선택
 	switch runtime.GOOS {
 	case "darwin": // Mac
 		if s.InterpMac != "" {
-			python = s.InterpMac
+			interp = s.InterpMac
 		}
 	case "windows":
 		if s.InterpWin != "" {
-			python = s.InterpWin
+			interp = s.InterpWin
 		}
 	case "linux":
 		if s.InterpUbuntu != "" {
-			python = s.InterpUbuntu
+			interp = s.InterpUbuntu
 		}
 	}
 
-	// 1순위: OS 전용, 2순위: Default(Legacy), 3순위: 앱 설정 기본값
-	if python == "" {
-		python = s.InterpDefault
+	// 1순위: OS 전용, 2순위: Default(Legacy/셔뱅), 3순위: 앱 설정 기본값(.py만)
+	if interp == "" {
+		interp = s.InterpDefault
 	}
-	if python == "" {
-		python = l.DefaultPythonPath
+	if interp == "" && extensionFor(s.Path) == ".py" {
+		interp = l.DefaultPythonPath
 	}
-	// 마지막 보루
-	if python == "" {
-		if runtime.GOOS == "windows" {
-			python = "python"
-		} else {
-			python = "/usr/bin/python3"
+	// 마지막 보루: 확장자별 Runner 기본값
+	if interp == "" {
+		if runner := runnerFor(extensionFor(s.Path)); runner != nil {
+			interp = runner.DefaultInterpreter(runtime.GOOS)
 		}
 	}
+	return interp
+}
+
+func (l *LauncherApp) runScript(s ScriptItem) {
+	python := l.resolveInterpreter(s)
+
+	l.recordLaunch(s.Path, time.Now().Unix())
 
 	fmt.Printf("Run Code: %s / Path: %s\n", s.Name, python)
 
@@ -557,33 +688,6 @@ func (l *LauncherApp) runScript(s ScriptItem) {
 	}()
 }
 
-func (l *LauncherApp) createTerminalCommand(python, scriptPath string) *exec.Cmd {
-	switch runtime.GOOS {
-	case "darwin":
-		// Mac: osascript를 사용하여 터미널 열기 등은 복잡하므로,
-		// 여기서는 'open' 명령어로 터미널에서 실행되도록 유도하거나
-		// 단순히 user choice에 따라 xterm 등을 호출.
-		// 가장 호환성 높은 방법: Terminal.app에 스크립트를 던짐.
-		// 하지만 python 인터프리터를 지정해서 열기는 까다로움.
-		// 대안: 새 창을 띄우는 open -a Terminal 사용 (인자 전달의 어려움 있음)
-		// 여기서는 "open"을 사용하여 기본 연결된 프로그램으로 열거나,
-		// apple script로 do script ... 수행.
-		
-		// 간단한 접근:
-		script := fmt.Sprintf(`tell application "Terminal" to do script "%s %s"`, python, scriptPath)
-		return exec.Command("osascript", "-e", script)
-		
-	case "windows":
-		// cmd /k "python script.py"
-		return exec.Command("cmd", "/C", "start", "cmd", "/k", python, scriptPath)
-	case "linux":
-		// x-terminal-emulator or gnome-terminal
-		return exec.Command("x-terminal-emulator", "-e", fmt.Sprintf("%s %s", python, scriptPath))
-	default:
-		return exec.Command(python, scriptPath)
-	}
-}
-
 // 파일 위치 열기
 func (l *LauncherApp) openFileLocation(s ScriptItem) {
 	dir := filepath.Dir(s.Path)
@@ -602,6 +706,10 @@ func (l *LauncherApp) loadPreferences() {
 	l.DefaultPythonPath = l.App.Preferences().StringWithFallback(KeyPythonPath, "/usr/bin/python3")
 	l.IconSize = float32(l.App.Preferences().FloatWithFallback(KeyIconSize, 80))
 	l.FontSize = float32(l.App.Preferences().FloatWithFallback(KeyFontSize, 12))
+	l.PreferredIconTheme = l.App.Preferences().String(KeyIconTheme)
+	l.TerminalTemplate = l.App.Preferences().String(KeyTerminalTemplate)
+	l.KeepTerminalOpen = l.App.Preferences().Bool(KeyKeepTerminalOpen)
+	l.Editor = l.App.Preferences().String(KeyEditor)
 
 	foldersJson := l.App.Preferences().String(KeyRegisteredFolders)
 	if foldersJson != "" {
@@ -613,11 +721,43 @@ func (l *LauncherApp) savePreferences() {
 	l.App.Preferences().SetString(KeyPythonPath, l.DefaultPythonPath)
 	l.App.Preferences().SetFloat(KeyIconSize, float64(l.IconSize))
 	l.App.Preferences().SetFloat(KeyFontSize, float64(l.FontSize))
+	l.App.Preferences().SetString(KeyIconTheme, l.PreferredIconTheme)
+	l.App.Preferences().SetString(KeyTerminalTemplate, l.TerminalTemplate)
+	l.App.Preferences().SetBool(KeyKeepTerminalOpen, l.KeepTerminalOpen)
+	l.App.Preferences().SetString(KeyEditor, l.Editor)
 
 	data, _ := json.Marshal(l.RegisteredFolders)
 	l.App.Preferences().SetString(KeyRegisteredFolders, string(data))
 }
 
+// 폴더 등록 (설정 다이얼로그 / IPC 양쪽에서 재사용)
+func (l *LauncherApp) addRegisteredFolder(path string) bool {
+	if path == "" {
+		return false
+	}
+	for _, f := range l.RegisteredFolders {
+		if f == path {
+			return false
+		}
+	}
+	l.RegisteredFolders = append(l.RegisteredFolders, path)
+	l.savePreferences()
+	l.refreshScripts()
+	return true
+}
+
+// 이름으로 스크립트 찾기 (IPC --run 등에서 사용)
+func (l *LauncherApp) findScriptByName(name string) (ScriptItem, bool) {
+	for _, scripts := range l.Scripts {
+		for _, s := range scripts {
+			if s.Name == name {
+				return s, true
+			}
+		}
+	}
+	return ScriptItem{}, false
+}
+
 // 설정 다이얼로그
 func (l *LauncherApp) showSettingsDialog() {
 	// 파이썬 경로
@@ -644,6 +784,41 @@ func (l *LauncherApp) showSettingsDialog() {
 	
 	fontContainer := container.NewBorder(nil, nil, nil, fontLabel, fontSlider)
 
+	// 아이콘 테마 선택 (Linux freedesktop 테마 전용)
+	themeOptions := append([]string{"(시스템 기본)"}, l.IconThemeIdx.ThemeNames()...)
+	themeSelect := widget.NewSelect(themeOptions, func(s string) {
+		if s == "(시스템 기본)" {
+			l.PreferredIconTheme = ""
+		} else {
+			l.PreferredIconTheme = s
+		}
+	})
+	if l.PreferredIconTheme == "" {
+		themeSelect.SetSelected("(시스템 기본)")
+	} else {
+		themeSelect.SetSelected(l.PreferredIconTheme)
+	}
+
+	// 터미널 커맨드 템플릿 ({cmd}/{interp}/{script}/{cwd} 치환)
+	terminalEntry := widget.NewEntry()
+	terminalEntry.SetText(l.TerminalTemplate)
+	terminalEntry.SetPlaceHolder("예: alacritty -e {cmd} (비워두면 자동 감지)")
+
+	keepOpenCheck := widget.NewCheck("스크립트 종료 후 터미널 유지", nil)
+	keepOpenCheck.Checked = l.KeepTerminalOpen
+
+	// 모션 감소 (깜빡이는 실행 애니메이션을 생략)
+	reducedMotionCheck := widget.NewCheck("애니메이션 줄이기 (모션 감소)", nil)
+	reducedMotionCheck.Checked = l.Accessibility.ReducedMotion
+	if osPrefersReducedMotion() {
+		reducedMotionCheck.Disable() // 환경변수가 이미 강제하고 있음
+	}
+
+	// 우클릭 메뉴의 "편집기로 열기"에 쓰일 외부 편집기
+	editorEntry := widget.NewEntry()
+	editorEntry.SetText(l.Editor)
+	editorEntry.SetPlaceHolder("예: code (비워두면 메뉴에 표시 안 함)")
+
 	// 폴더 리스트
 	folderList := widget.NewList(
 		func() int { return len(l.RegisteredFolders) },
@@ -673,18 +848,7 @@ func (l *LauncherApp) showSettingsDialog() {
 	addFolderBtn := widget.NewButtonWithIcon("폴더 추가", theme.ContentAddIcon(), func() {
 		dialog.ShowFolderOpen(func(uri fyne.ListableURI, err error) {
 			if err == nil && uri != nil {
-				path := uri.Path()
-				exists := false
-				for _, f := range l.RegisteredFolders {
-					if f == path {
-						exists = true
-						break
-					}
-				}
-				if !exists {
-					l.RegisteredFolders = append(l.RegisteredFolders, path)
-					l.savePreferences()
-					l.refreshScripts()
+				if l.addRegisteredFolder(uri.Path()) {
 					folderList.Refresh()
 				}
 			}
@@ -699,6 +863,18 @@ func (l *LauncherApp) showSettingsDialog() {
 		widget.NewLabelWithStyle("라벨 폰트 크기:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		fontContainer,
 		widget.NewSeparator(),
+		widget.NewLabelWithStyle("아이콘 테마:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		themeSelect,
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle("터미널 커맨드 템플릿:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		terminalEntry,
+		keepOpenCheck,
+		widget.NewSeparator(),
+		reducedMotionCheck,
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle("외부 편집기:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		editorEntry,
+		widget.NewSeparator(),
 		widget.NewLabelWithStyle("등록된 폴더:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		addFolderBtn,
 		folderScroll,
@@ -709,7 +885,14 @@ func (l *LauncherApp) showSettingsDialog() {
 
 	d.SetOnClosed(func() {
 		l.DefaultPythonPath = pythonEntry.Text
+		l.TerminalTemplate = terminalEntry.Text
+		l.KeepTerminalOpen = keepOpenCheck.Checked
+		l.Editor = editorEntry.Text
+		if !osPrefersReducedMotion() {
+			l.Accessibility.ReducedMotion = reducedMotionCheck.Checked
+		}
 		l.savePreferences()
+		l.saveAccessibilityPreferences()
 		l.refreshScripts() // 폰트 변경 반영을 위해 갱신 필요 (사실 updateGridUI만 해도 되지만 단순화)
 	})
 
@@ -718,83 +901,116 @@ func (l *LauncherApp) showSettingsDialog() {
 
 // 속성 다이얼로그
 func (l *LauncherApp) showPropertiesDialog(s ScriptItem) {
+	runner := runnerFor(extensionFor(s.Path))
+	tag := "#pqr"
+	if runner != nil {
+		tag = runner.HeaderTag()
+	}
+	// Mac/Win/Ubuntu 실행기 오버라이드는 OS별로 기본 인터프리터가 달라지는 언어(Python/Shell)에만
+	// 의미가 있다. Node처럼 OS와 무관하게 같은 인터프리터를 쓰는 언어에서는 숨기고
+	// 감지된 언어 하나에만 해당하는 "실행기" 필드를 대신 보여준다.
+	perOSInterpreters := extensionFor(s.Path) != ".js"
+
 	catEntry := widget.NewEntry()
 	catEntry.SetText(s.Category)
 
-	macEntry := widget.NewEntry()
-	macEntry.SetText(s.InterpMac)
+	termCheck := widget.NewCheck("터미널 창 열기", nil)
+	termCheck.Checked = s.Terminal
 
-	winEntry := widget.NewEntry()
-	winEntry.SetText(s.InterpWin)
+	var formItems []*widget.FormItem
+	formItems = append(formItems, widget.NewFormItem("카테고리", catEntry))
 
+	macEntry := widget.NewEntry()
+	winEntry := widget.NewEntry()
 	ubuEntry := widget.NewEntry()
-	ubuEntry.SetText(s.InterpUbuntu)
-	
-	termCheck := widget.NewCheck("터미널 창 열기", nil)
-	termCheck.Checked = s.Terminal
+	interpEntry := widget.NewEntry()
+
+	if perOSInterpreters {
+		macEntry.SetText(s.InterpMac)
+		winEntry.SetText(s.InterpWin)
+		ubuEntry.SetText(s.InterpUbuntu)
+		formItems = append(formItems,
+			widget.NewFormItem("Mac 실행기", macEntry),
+			widget.NewFormItem("Win 실행기", winEntry),
+			widget.NewFormItem("Ubuntu 실행기", ubuEntry),
+		)
+	} else {
+		interpEntry.SetText(s.InterpDefault)
+		formItems = append(formItems, widget.NewFormItem("실행기", interpEntry))
+	}
 
-	form := widget.NewForm(
-		widget.NewFormItem("카테고리", catEntry),
-		widget.NewFormItem("Mac 실행기", macEntry),
-		widget.NewFormItem("Win 실행기", winEntry),
-		widget.NewFormItem("Ubuntu 실행기", ubuEntry),
-		widget.NewFormItem("", termCheck),
+	formItems = append(formItems, widget.NewFormItem("", termCheck))
+	form := widget.NewForm(formItems...)
+
+	descTab, stopDescWatch := newDescriptionTab(s)
+
+	tabs := container.NewAppTabs(
+		widget.NewTabItem("속성", form),
+		widget.NewTabItem("설명", descTab),
 	)
 
-	d := dialog.NewCustomConfirm("스크립트 속성", "저장", "취소", form, func(b bool) {
+	d := dialog.NewCustomConfirm("스크립트 속성", "저장", "취소", tabs, func(b bool) {
 		if b {
-			l.updateScriptMetadata(s, catEntry.Text, macEntry.Text, winEntry.Text, ubuEntry.Text, termCheck.Checked)
+			if perOSInterpreters {
+				l.updateScriptMetadata(s, tag, catEntry.Text, macEntry.Text, winEntry.Text, ubuEntry.Text, termCheck.Checked)
+			} else {
+				// OS 무관 단일 실행기: 세 OS 태그 모두에 같은 값을 써서
+				// resolveInterpreter가 현재 OS와 상관없이 이 값을 찾게 한다.
+				l.updateScriptMetadata(s, tag, catEntry.Text, interpEntry.Text, interpEntry.Text, interpEntry.Text, termCheck.Checked)
+			}
 			l.refreshScripts()
 		}
 	}, l.Window)
-	
+
+	d.SetOnClosed(stopDescWatch)
 	d.Resize(fyne.NewSize(500, 400))
 	d.Show()
 }
 
-// 메타데이터 업데이트 (파일 쓰기)
-func (l *LauncherApp) updateScriptMetadata(s ScriptItem, cat, mac, win, ubu string, term bool) {
+// 메타데이터 업데이트 (파일 쓰기). tag는 스크립트 언어에 맞는 헤더 태그 접두사
+// (Runner.HeaderTag, 예: "#pqr"/"//pqr")로, 이 언어의 주석 문법에 맞게 쓰고/지운다.
+func (l *LauncherApp) updateScriptMetadata(s ScriptItem, tag, cat, mac, win, ubu string, term bool) {
 	content, err := ioutil.ReadFile(s.Path)
 	if err != nil {
 		dialog.ShowError(err, l.Window)
 		return
 	}
-	
+
 	lines := strings.Split(string(content), "\n")
 	var newLines []string
-	
+
 	// Shebang 보존 확인
 	hasShebang := len(lines) > 0 && strings.HasPrefix(lines[0], "#!")
 	if hasShebang {
 		newLines = append(newLines, lines[0])
 	}
-	
-	// 새 태그 생성
-	newLines = append(newLines, fmt.Sprintf("#pqr cat \"%s\"", cat))
-	if mac != "" { newLines = append(newLines, fmt.Sprintf("#pqr mac \"%s\"", mac)) }
-	if win != "" { newLines = append(newLines, fmt.Sprintf("#pqr win \"%s\"", win)) }
-	if ubu != "" { newLines = append(newLines, fmt.Sprintf("#pqr ubuntu \"%s\"", ubu)) }
-	if term { newLines = append(newLines, "#pqr terminal true") }
-	
-	// 기존 내용 중 #pqr 태그 제거 (상단 20줄 이내)
+
+	// 새 태그 생성 (이 언어의 주석 문법에 맞는 tag 접두사 사용)
+	newLines = append(newLines, fmt.Sprintf("%s cat \"%s\"", tag, cat))
+	if mac != "" { newLines = append(newLines, fmt.Sprintf("%s mac \"%s\"", tag, mac)) }
+	if win != "" { newLines = append(newLines, fmt.Sprintf("%s win \"%s\"", tag, win)) }
+	if ubu != "" { newLines = append(newLines, fmt.Sprintf("%s ubuntu \"%s\"", tag, ubu)) }
+	if term { newLines = append(newLines, fmt.Sprintf("%s terminal true", tag)) }
+
+	// 기존 내용 중 이 언어의 태그 줄 제거 (상단 30줄 이내)
 	for i, line := range lines {
 		if hasShebang && i == 0 {
 			continue
 		}
-		
+
 		isTag := false
 		if i < 30 { // 30줄까지만 검사
 			trim := strings.TrimSpace(line)
-			if strings.HasPrefix(trim, "#pqr") {
+			if strings.HasPrefix(trim, tag) {
 				isTag = true
 			}
 		}
-		
+
 		if !isTag {
 			newLines = append(newLines, line)
 		}
 	}
-	
+
 	err = ioutil.WriteFile(s.Path, []byte(strings.Join(newLines, "\n")), 0644)
 	if err != nil {
 		dialog.ShowError(err, l.Window)
@@ -828,14 +1044,20 @@ type ScriptWidget struct {
 	app  *LauncherApp
 
 	lastTap time.Time
-	
+
+	// 라벨 줄바꿈/말줄임표 설정
+	MaxLines int
+	Ellipsis EllipsisMode
+	truncated bool
+
 	// UI Elements for manipulation
 	background *canvas.Rectangle
 	icon       *canvas.Image
+	hoverPopUp *widget.PopUp
 }
 
 func NewScriptWidget(item ScriptItem, app *LauncherApp) *ScriptWidget {
-	w := &ScriptWidget{item: item, app: app}
+	w := &ScriptWidget{item: item, app: app, MaxLines: 2, Ellipsis: EllipsisTail}
 	w.ExtendBaseWidget(w)
 	return w
 }
@@ -864,8 +1086,13 @@ func (w *ScriptWidget) CreateRenderer() fyne.WidgetRenderer {
 	// 텍스트 래핑 헬퍼를 사용하여 줄별로 분리하고, 각 줄을 별도의 canvas.Text로 렌더링
 	// canvas.Text는 개행 문자(\n)를 지원하지 않아 "다이아몬드(?)" 문자가 발생하므로 분리 필수.
 	
-	lines := wrapSmart(w.item.Name, w.app.FontSize, w.app.IconSize+30) // Width 약간 여유
-	
+	labelMaxWidth := w.app.IconSize + 30 // Width 약간 여유
+	lines, truncated := wrapSmart(w.item.Name, w.app.FontSize, labelMaxWidth, w.MaxLines)
+	if truncated {
+		lines = applyEllipsis(lines, w.app.FontSize, labelMaxWidth, w.Ellipsis)
+	}
+	w.truncated = truncated
+
 	labelVBox := container.NewVBox()
 	for _, line := range lines {
 		txt := canvas.NewText(line, theme.ForegroundColor())
@@ -901,14 +1128,28 @@ func (w *ScriptWidget) CreateRenderer() fyne.WidgetRenderer {
 }
 
 // Hoverable 인터페이스 구현
-func (w *ScriptWidget) MouseIn(*desktop.MouseEvent) {
+func (w *ScriptWidget) MouseIn(ev *desktop.MouseEvent) {
 	w.background.FillColor = theme.HoverColor()
 	w.background.Refresh()
+
+	w.app.updateDescriptionPane(w.item)
+
+	// 이름이 말줄임표로 잘린 경우, 전체 이름을 보여주는 팝업을 커서 근처에 띄운다
+	if w.truncated {
+		label := widget.NewLabel(w.item.Name)
+		w.hoverPopUp = widget.NewPopUp(label, w.app.Window.Canvas())
+		w.hoverPopUp.ShowAtPosition(ev.AbsolutePosition)
+	}
 }
 
 func (w *ScriptWidget) MouseOut() {
 	w.background.FillColor = color.Transparent
 	w.background.Refresh()
+
+	if w.hoverPopUp != nil {
+		w.hoverPopUp.Hide()
+		w.hoverPopUp = nil
+	}
 }
 
 func (w *ScriptWidget) MouseMoved(*desktop.MouseEvent) {}
@@ -924,107 +1165,42 @@ func (w *ScriptWidget) Tapped(e *fyne.PointEvent) {
 	}
 }
 
+// animateLaunch는 더블 클릭 시 아이콘을 살짝 페이드아웃했다가 페이드인하고,
+// 배경을 Selection -> Hover 색상으로 플래시한다. 두 효과 모두 "페이드아웃 뒤
+// 페이드인"하는 같은 모양이므로 Chain으로 이어붙인 트랙 하나씩으로 표현한다.
 func (w *ScriptWidget) animateLaunch() {
-	// 펄스 애니메이션 (작아졌다 커짐)
-	
-	// Simple scale animation: Fyne doesn't support direct scale transform on all objects easily without custom layout,
-	// but we can animate opacity or simple sizing if layout permits.
-	// For immediate visual feedback, let's flash the background and fade the icon slightly.
-	
-	fade := fyne.NewAnimation(200*time.Millisecond, func(v float32) {
-		// v goes 0 -> 1
-		// Opacity: 1 -> 0.5 -> 1
-		if v < 0.5 {
-			w.icon.Translucency = float64(v) // 0 -> 0.5 (fadout)
-		} else {
-			w.icon.Translucency = float64(1 - v) // 0.5 -> 0 (fadein)
-		}
-		w.icon.Refresh()
-		
-		// Background flash
-		if v < 0.5 {
-			w.background.FillColor = theme.SelectionColor()
-		} else {
-			w.background.FillColor = theme.HoverColor() // Return to hover state
-		}
-		w.background.Refresh()
-	})
-	fade.Start()
-}
-
-// 텍스트 래핑 헬퍼 함수 (개선됨: 긴 단어 자르기 포함)
-func wrapSmart(text string, size float32, maxWidth float32) []string {
-	if text == "" {
-		return []string{}
-	}
-	
-	style := fyne.TextStyle{}
-	var lines []string
-	var currentLine string
-
-	// 1. 이미 줄바꿈이 있는 경우 처리? (일단 무시하고 one block으로 봄 or split)
-	// 단순화를 위해 전체를 run array로 변환하여 처리 (Character Wrap)
-	// 단어 단위 보존을 위해 먼저 Fields로 나누고, 너무 긴 단어는 쪼갭니다.
-	
-	words := strings.Fields(text)
-	for _, word := range words {
-		// 단어 자체가 maxWidth보다 긴 경우: 강제로 쪼개야 함
-		if fyne.MeasureText(word, size, style).Width > maxWidth {
-			// 현재 라인 비우고 시작
-			if currentLine != "" {
-				lines = append(lines, currentLine)
-				currentLine = ""
-			}
-			
-			// 글자 단위로 쪼개서 넣기
-			runes := []rune(word)
-			chunk := ""
-			for _, r := range runes {
-				testChunk := chunk + string(r)
-				if fyne.MeasureText(testChunk, size, style).Width <= maxWidth {
-					chunk = testChunk
-				} else {
-					lines = append(lines, chunk)
-					chunk = string(r)
-				}
-			}
-			if chunk != "" {
-				currentLine = chunk // 마지막 조각을 현재 라인으로
-			}
-		} else {
-			// 일반 단어 처리
-			testLine := word
-			if currentLine != "" {
-				testLine = currentLine + " " + word
-			}
-			
-			if fyne.MeasureText(testLine, size, style).Width <= maxWidth {
-				currentLine = testLine
-			} else {
-				lines = append(lines, currentLine)
-				currentLine = word
-			}
-		}
-	}
-	if currentLine != "" {
-		lines = append(lines, currentLine)
-	}
-
-	// 최대 2줄 제한
-	if len(lines) > 2 {
-		lines = lines[:2]
-		// lines[1] += "..." // 생략 표시 (선택사항)
-	}
+	iconTrack := Chain(
+		Opacity(func(v float64) { w.icon.Translucency = v; w.icon.Refresh() }, 0, 0.5),
+		Opacity(func(v float64) { w.icon.Translucency = v; w.icon.Refresh() }, 0.5, 0),
+	)
+	backgroundTrack := Chain(
+		ColorLerp(func(c color.Color) { w.background.FillColor = c; w.background.Refresh() }, theme.HoverColor(), theme.SelectionColor()),
+		ColorLerp(func(c color.Color) { w.background.FillColor = c; w.background.Refresh() }, theme.SelectionColor(), theme.HoverColor()),
+	)
 
-	return lines
+	animator := NewAnimator(200*time.Millisecond, EaseInOut)
+	animator.ReducedMotion = w.app.Accessibility.ReducedMotion
+	animator.AddTrack(iconTrack).AddTrack(backgroundTrack).Start()
 }
 
 func (w *ScriptWidget) TappedSecondary(e *fyne.PointEvent) {
-	menu := fyne.NewMenu("",
+	items := []*fyne.MenuItem{
 		fyne.NewMenuItem("실행", func() { w.app.runScript(w.item) }),
-		fyne.NewMenuItem("파일위치 열기", func() { w.app.openFileLocation(w.item) }),
-		fyne.NewMenuItem("속성", func() { w.app.showPropertiesDialog(w.item) }),
-	)
-	
+	}
+	items = append(items, w.app.buildScriptActionMenuItems(&w.item)...)
+
+	menu := fyne.NewMenu("", items...)
 	widget.ShowPopUpMenuAtPosition(menu, w.app.Window.Canvas(), e.AbsolutePosition)
+}
+
+// 바로가기 내보낼 위치를 고른 뒤 exportShortcut을 호출
+func (l *LauncherApp) showExportShortcutDialog(s ScriptItem) {
+	dialog.ShowFolderOpen(func(uri fyne.ListableURI, err error) {
+		if err != nil || uri == nil {
+			return
+		}
+		if err := l.exportShortcut(s, uri.Path()); err != nil {
+			dialog.ShowError(err, l.Window)
+		}
+	}, l.Window)
 }
\ No newline at end of file
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// --- 실행기(Runner) 레지스트리 ---
+// runScript/parseHeader에 하드코딩되어 있던 "파이썬 전용" 가정을 확장자별 Runner로 분리한다.
+// 확장자마다 OS별 기본 인터프리터와 헤더 태그 네임스페이스(#pqr, //pqr, --pqr ...)가 다르므로
+// 각 Runner가 그 규칙을 책임지고, 레지스트리는 확장자 -> Runner 매핑만 담당한다.
+
+// Runner는 하나의 스크립트 언어(확장자)를 실행하는 방법을 기술한다.
+type Runner interface {
+	// Extension은 이 Runner가 담당하는 확장자(".py" 등)를 반환한다.
+	Extension() string
+	// HeaderTag는 이 언어의 주석 문법에 맞는 메타데이터 태그 접두사다 (예: "#pqr", "//pqr").
+	HeaderTag() string
+	// DefaultInterpreter는 goos(runtime.GOOS)별 기본 인터프리터 경로/이름을 반환한다.
+	DefaultInterpreter(goos string) string
+}
+
+var runnerRegistry = map[string]Runner{}
+
+// RegisterRunner는 확장자에 대한 Runner를 등록한다. 사용자 플러그인이 호출할 수 있도록 공개되어 있다.
+func RegisterRunner(ext string, r Runner) {
+	runnerRegistry[ext] = r
+}
+
+// runnerFor는 확장자(".py" 등)에 등록된 Runner를 찾는다.
+func runnerFor(ext string) Runner {
+	return runnerRegistry[ext]
+}
+
+func init() {
+	RegisterRunner(".py", pythonRunner{})
+	RegisterRunner(".sh", shellRunner{})
+	RegisterRunner(".js", nodeRunner{})
+}
+
+type pythonRunner struct{}
+
+func (pythonRunner) Extension() string { return ".py" }
+func (pythonRunner) HeaderTag() string { return "#pqr" }
+func (pythonRunner) DefaultInterpreter(goos string) string {
+	if goos == "windows" {
+		return "python"
+	}
+	return "/usr/bin/python3"
+}
+
+type shellRunner struct{}
+
+func (shellRunner) Extension() string { return ".sh" }
+func (shellRunner) HeaderTag() string { return "#pqr" }
+func (shellRunner) DefaultInterpreter(goos string) string {
+	if goos == "windows" {
+		return "bash"
+	}
+	return "/bin/bash"
+}
+
+type nodeRunner struct{}
+
+func (nodeRunner) Extension() string { return ".js" }
+func (nodeRunner) HeaderTag() string { return "//pqr" }
+func (nodeRunner) DefaultInterpreter(goos string) string {
+	return "node"
+}
+
+// parseShebang은 파일 첫 줄이 "#!"로 시작하면 그 인터프리터 경로를 반환한다.
+// 실제 exec용 shebang이 아닌 #pqr 메타데이터와 구분하기 위해 첫 줄만 확인한다.
+func parseShebang(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return ""
+	}
+	line := strings.TrimSpace(scanner.Text())
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, "#!"))
+}
+
+// extensionFor는 경로의 확장자를 돌려준다 (filepath.Ext 래퍼, Runner 조회용).
+func extensionFor(path string) string {
+	return filepath.Ext(path)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// --- 바로가기(Shortcut) 내보내기 ---
+// 선택한 스크립트를 OS 네이티브 런처(데스크톱, 시작 메뉴 등)에서 바로 실행할 수 있도록
+// Linux는 .desktop, Windows는 .bat, macOS는 .app 번들을 생성한다 (.lnk와 .command는 만들지 않는다).
+
+// exportShortcut은 s를 destDir(보통 데스크톱 경로)에 네이티브 바로가기로 내보낸다.
+func (l *LauncherApp) exportShortcut(s ScriptItem, destDir string) error {
+	switch runtime.GOOS {
+	case "windows":
+		return l.exportShortcutWindows(s, destDir)
+	case "darwin":
+		return l.exportShortcutMac(s, destDir)
+	default:
+		return l.exportShortcutLinux(s, destDir)
+	}
+}
+
+// Linux: freedesktop .desktop 파일
+func (l *LauncherApp) exportShortcutLinux(s ScriptItem, destDir string) error {
+	python := l.resolveInterpreter(s)
+	iconPath := l.stableIconPath(s, destDir)
+
+	exec := fmt.Sprintf("%s %s", python, s.Path)
+
+	var b strings.Builder
+	b.WriteString("[Desktop Entry]\n")
+	b.WriteString("Type=Application\n")
+	fmt.Fprintf(&b, "Name=%s\n", s.Name)
+	fmt.Fprintf(&b, "Comment=PyQuickBox script: %s\n", s.Name)
+	fmt.Fprintf(&b, "Exec=%s\n", exec)
+	if iconPath != "" {
+		fmt.Fprintf(&b, "Icon=%s\n", iconPath)
+	}
+	if s.Terminal {
+		b.WriteString("Terminal=true\n")
+	} else {
+		b.WriteString("Terminal=false\n")
+	}
+	if s.Category != "" {
+		fmt.Fprintf(&b, "Categories=%s;\n", s.Category)
+	}
+
+	destPath := filepath.Join(destDir, s.Name+".desktop")
+	if err := ioutil.WriteFile(destPath, []byte(b.String()), 0644); err != nil {
+		return err
+	}
+	return os.Chmod(destPath, 0755)
+}
+
+// Windows: 인터프리터로 스크립트를 실행하는 .bat 파일 (COM 셸 호출로 .lnk를 만들지는 않는다)
+func (l *LauncherApp) exportShortcutWindows(s ScriptItem, destDir string) error {
+	python := l.resolveInterpreter(s)
+	destPath := filepath.Join(destDir, s.Name+".bat")
+	content := fmt.Sprintf("@echo off\r\n\"%s\" \"%s\" %%*\r\n", python, s.Path)
+	return ioutil.WriteFile(destPath, []byte(content), 0644)
+}
+
+// macOS: 최소 구성의 .app 번들 (Info.plist + Contents/MacOS 실행 스크립트)
+func (l *LauncherApp) exportShortcutMac(s ScriptItem, destDir string) error {
+	python := l.resolveInterpreter(s)
+
+	appDir := filepath.Join(destDir, s.Name+".app")
+	macOSDir := filepath.Join(appDir, "Contents", "MacOS")
+	if err := os.MkdirAll(macOSDir, 0755); err != nil {
+		return err
+	}
+
+	plist := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleExecutable</key>
+	<string>launch</string>
+	<key>CFBundleName</key>
+	<string>%s</string>
+	<key>CFBundlePackageType</key>
+	<string>APPL</string>
+</dict>
+</plist>
+`, s.Name)
+	if err := ioutil.WriteFile(filepath.Join(appDir, "Contents", "Info.plist"), []byte(plist), 0644); err != nil {
+		return err
+	}
+
+	launchScript := fmt.Sprintf("#!/bin/sh\nexec \"%s\" \"%s\"\n", python, s.Path)
+	launchPath := filepath.Join(macOSDir, "launch")
+	if err := ioutil.WriteFile(launchPath, []byte(launchScript), 0755); err != nil {
+		return err
+	}
+	return os.Chmod(launchPath, 0755)
+}
+
+// stableIconPath는 IconPath를 destDir 아래 고정된 위치로 복사하고 그 경로를 반환한다.
+// 원본 아이콘이 없으면 빈 문자열을 반환한다.
+func (l *LauncherApp) stableIconPath(s ScriptItem, destDir string) string {
+	if s.IconPath == "" {
+		return ""
+	}
+	data, err := ioutil.ReadFile(s.IconPath)
+	if err != nil {
+		return ""
+	}
+	dest := filepath.Join(destDir, "."+s.Name+"-icon"+filepath.Ext(s.IconPath))
+	if err := ioutil.WriteFile(dest, data, 0644); err != nil {
+		return ""
+	}
+	return dest
+}
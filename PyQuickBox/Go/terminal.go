@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// --- 터미널 에뮬레이터 자동 감지 / 커맨드 템플릿 ---
+// createTerminalCommand가 Debian 전용인 x-terminal-emulator만 찾던 것을 대체한다.
+// Arch/Fedora/Wayland 등에서도 동작하도록 흔히 쓰이는 터미널들을 순서대로 탐색하고,
+// 사용자가 설정에서 "{cmd}" 커맨드 템플릿으로 직접 지정할 수도 있게 한다.
+
+// linuxTerminalCandidates는 감지 우선순위 순서다.
+var linuxTerminalCandidates = []string{
+	"kitty", "alacritty", "wezterm", "foot",
+	"gnome-terminal", "konsole", "xfce4-terminal", "xterm",
+}
+
+var (
+	detectedTerminalOnce sync.Once
+	detectedTerminalPath string
+)
+
+// detectLinuxTerminal은 PATH에서 먼저 발견되는 터미널 에뮬레이터를 찾아 캐시한다.
+func detectLinuxTerminal() string {
+	detectedTerminalOnce.Do(func() {
+		for _, candidate := range linuxTerminalCandidates {
+			if path, err := exec.LookPath(candidate); err == nil {
+				detectedTerminalPath = path
+				return
+			}
+		}
+		detectedTerminalPath = "x-terminal-emulator" // 마지막 보루 (Debian 계열 기본값)
+	})
+	return detectedTerminalPath
+}
+
+// detectWindowsTerminal은 Windows Terminal(wt.exe 또는 WindowsTerminal.exe)의 경로를 반환하고,
+// 둘 다 없으면 빈 문자열을 반환한다 (그 경우 호출자가 cmd로 폴백한다).
+func detectWindowsTerminal() string {
+	if path, err := exec.LookPath("wt.exe"); err == nil {
+		return path
+	}
+	if path, err := exec.LookPath("WindowsTerminal.exe"); err == nil {
+		return path
+	}
+	return ""
+}
+
+// wrapKeepOpen은 "KeepTerminalOpen" 옵션이 켜졌을 때 스크립트 종료 후에도 터미널이
+// 바로 닫히지 않도록 셸별로 대기 문구를 덧붙인다 (트레이스백을 읽을 수 있게).
+func wrapKeepOpen(innerCmd string) string {
+	if runtime.GOOS == "windows" {
+		return innerCmd // Windows는 cmd /k 자체가 대기 역할을 함
+	}
+	return fmt.Sprintf(`%s; read -p "Press enter to close"`, innerCmd)
+}
+
+// expandTerminalTemplate은 사용자가 설정한 커맨드 템플릿의 {cmd}/{interp}/{script}/{cwd}를 치환한다.
+func expandTerminalTemplate(template, interp, script, cwd string) string {
+	r := strings.NewReplacer(
+		"{cmd}", fmt.Sprintf("%s %s", interp, script),
+		"{interp}", interp,
+		"{script}", script,
+		"{cwd}", cwd,
+	)
+	return r.Replace(template)
+}
+
+func (l *LauncherApp) createTerminalCommand(python, scriptPath string) *exec.Cmd {
+	innerCmd := fmt.Sprintf("%s %s", python, scriptPath)
+	if l.KeepTerminalOpen {
+		innerCmd = wrapKeepOpen(innerCmd)
+	}
+
+	// 사용자 지정 템플릿이 있으면 최우선으로 사용 (예: "alacritty -e {cmd}")
+	if l.TerminalTemplate != "" {
+		cwd := filepath.Dir(scriptPath)
+		expanded := expandTerminalTemplate(l.TerminalTemplate, python, scriptPath, cwd)
+		parts := strings.Fields(expanded)
+		if len(parts) > 0 {
+			return exec.Command(parts[0], parts[1:]...)
+		}
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf(`tell application "Terminal" to do script "%s"`, innerCmd)
+		return exec.Command("osascript", "-e", script)
+
+	case "windows":
+		flag := "/C"
+		if l.KeepTerminalOpen {
+			flag = "/K"
+		}
+		if wt := detectWindowsTerminal(); wt != "" {
+			return exec.Command(wt, "cmd", flag, python, scriptPath)
+		}
+		return exec.Command("cmd", "/C", "start", "cmd", flag, python, scriptPath)
+
+	case "linux":
+		// kitty/alacritty/foot/xterm 등은 -e 뒤의 인자를 execvp로 그대로 실행하므로
+		// (셸처럼 한 문자열을 파싱하지 않는다) innerCmd를 "sh -c <innerCmd>"로
+		// 별도 argv 요소로 넘겨 셸이 직접 해석하게 한다.
+		term := detectLinuxTerminal()
+		return exec.Command(term, "-e", "sh", "-c", innerCmd)
+
+	default:
+		return exec.Command(python, scriptPath)
+	}
+}
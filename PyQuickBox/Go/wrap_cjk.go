@@ -0,0 +1,173 @@
+package main
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// --- CJK 인지 텍스트 래핑 ---
+// 기존 wrapSmart는 strings.Fields로 단어를 나눴는데, 한글/한자/가나처럼 공백으로
+// 단어가 구분되지 않는 언어에서는 문장 전체가 "하나의 긴 단어"로 취급되어
+// 글자 단위 강제 줄바꿈으로 빠지면서 폭 계산이 엉망이 되는 문제가 있었다.
+// (gocui의 CJK 패치와 같은 종류의 수정: 동아시아 전각 문자는 글자 단위로 줄바꿈 가능하고
+// 폭이 2배라는 점을 반영해야 한다.)
+
+// isWideRune은 East Asian Wide/Fullwidth 범주에 속하는 룬인지 판별한다.
+// (한글 자모, CJK 통합 한자/가나, 한글 음절, 호환 한자, 세로쓰기 호환 형태, 전각 문자 등)
+func isWideRune(r rune) bool {
+	switch {
+	case r >= 0x1100 && r <= 0x115F: // Hangul Jamo
+		return true
+	case r >= 0x2E80 && r <= 0x9FFF: // CJK Radicals ~ CJK Unified Ideographs
+		return true
+	case r >= 0xA960 && r <= 0xA97F: // Hangul Jamo Extended-A
+		return true
+	case r >= 0xAC00 && r <= 0xD7A3: // Hangul Syllables
+		return true
+	case r >= 0xF900 && r <= 0xFAFF: // CJK Compatibility Ideographs
+		return true
+	case r >= 0xFE30 && r <= 0xFE4F: // CJK Compatibility Forms
+		return true
+	case r >= 0xFF00 && r <= 0xFF60: // Fullwidth Forms
+		return true
+	case r >= 0xFFE0 && r <= 0xFFE6: // Fullwidth Signs
+		return true
+	}
+	return false
+}
+
+type wrapToken struct {
+	text  string
+	isCJK bool
+}
+
+// tokenizeForWrap은 텍스트를 ASCII/Latin 단어 토큰과 CJK 낱글자 토큰으로 분해한다.
+// CJK 낱글자는 공백 없이도 줄바꿈될 수 있으므로 각각 독립된 토큰으로 만든다.
+func tokenizeForWrap(text string) []wrapToken {
+	var tokens []wrapToken
+	var buf []rune
+
+	flush := func() {
+		if len(buf) > 0 {
+			tokens = append(tokens, wrapToken{text: string(buf)})
+			buf = nil
+		}
+	}
+
+	for _, r := range text {
+		if r == ' ' || r == '\t' {
+			flush()
+			continue
+		}
+		if isWideRune(r) {
+			flush()
+			tokens = append(tokens, wrapToken{text: string(r), isCJK: true})
+			continue
+		}
+		buf = append(buf, r)
+	}
+	flush()
+
+	return tokens
+}
+
+// wrapParagraph는 개행이 없는 한 문단을 maxWidth에 맞춰 줄 목록으로 래핑한다.
+func wrapParagraph(text string, size, maxWidth float32, style fyne.TextStyle) []string {
+	tokens := tokenizeForWrap(text)
+
+	var lines []string
+	var current string
+	hasCurrent := false
+	lastWasCJK := false
+
+	flushLine := func() {
+		if hasCurrent {
+			lines = append(lines, current)
+			current = ""
+			hasCurrent = false
+		}
+	}
+
+	for _, t := range tokens {
+		if t.isCJK {
+			candidate := current + t.text
+			if fyne.MeasureText(candidate, size, style).Width <= maxWidth {
+				current = candidate
+				hasCurrent = true
+			} else {
+				flushLine()
+				current = t.text // 한 글자가 maxWidth보다 넓어도 더 쪼갤 수 없으니 그대로 둔다
+				hasCurrent = true
+			}
+			lastWasCJK = true
+			continue
+		}
+
+		// 단어 자체가 maxWidth보다 긴 ASCII/Latin 단어: 글자 단위로 쪼갠다
+		if fyne.MeasureText(t.text, size, style).Width > maxWidth {
+			flushLine()
+			runes := []rune(t.text)
+			chunk := ""
+			for _, r := range runes {
+				test := chunk + string(r)
+				if fyne.MeasureText(test, size, style).Width <= maxWidth {
+					chunk = test
+				} else {
+					if chunk != "" {
+						lines = append(lines, chunk)
+					}
+					chunk = string(r)
+				}
+			}
+			current = chunk
+			hasCurrent = chunk != ""
+			lastWasCJK = false
+			continue
+		}
+
+		var candidate string
+		switch {
+		case !hasCurrent:
+			candidate = t.text
+		case lastWasCJK:
+			candidate = current + t.text // CJK 뒤에는 공백 없이 붙인다
+		default:
+			candidate = current + " " + t.text
+		}
+
+		if fyne.MeasureText(candidate, size, style).Width <= maxWidth {
+			current = candidate
+			hasCurrent = true
+		} else {
+			flushLine()
+			current = t.text
+			hasCurrent = true
+		}
+		lastWasCJK = false
+	}
+	flushLine()
+
+	return lines
+}
+
+// wrapSmart는 라벨을 maxWidth 안에 들어가는 줄들로 나눈다. 기존 입력에 있던 "\n"은
+// 먼저 문단 단위로 분리한 뒤 각 문단을 독립적으로 래핑한다.
+// maxLines를 넘는 경우 잘라내고, 두 번째 반환값으로 잘림 여부를 알려준다
+// (호출자가 말줄임표/호버 툴팁 표시 여부를 결정하는 데 사용).
+func wrapSmart(text string, size float32, maxWidth float32, maxLines int) ([]string, bool) {
+	if text == "" {
+		return []string{}, false
+	}
+
+	style := fyne.TextStyle{}
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines = append(lines, wrapParagraph(paragraph, size, maxWidth, style)...)
+	}
+
+	if len(lines) > maxLines {
+		return lines[:maxLines], true
+	}
+	return lines, false
+}